@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorList is a collection of Errors with aggregation helpers, letting
+// consumers filter, deduplicate, and join them without ad-hoc
+// post-processing.
+type ErrorList []Error
+
+// Filter returns the subset of the receiver whose Type is one of types. An
+// empty types returns the receiver unchanged.
+func (list ErrorList) Filter(types ...ErrorType) ErrorList {
+	if len(types) == 0 {
+		return list
+	}
+
+	wanted := make(map[ErrorType]struct{}, len(types))
+	for _, t := range types {
+		wanted[t] = struct{}{}
+	}
+
+	var out ErrorList
+	for _, err := range list {
+		if _, ok := wanted[err.Type]; ok {
+			out = append(out, err)
+		}
+	}
+	return out
+}
+
+// HasError reports whether the receiver contains at least one Error.
+func (list ErrorList) HasError() bool {
+	return len(list) > 0
+}
+
+// Dedup collapses Errors in the receiver that share the same (Type, Field,
+// BadValue), keeping the first occurrence of each. This is useful when the
+// same missing field is reported by multiple Validators.
+func (list ErrorList) Dedup() ErrorList {
+	type key struct {
+		errType  ErrorType
+		field    string
+		badValue string
+	}
+
+	seen := make(map[key]struct{}, len(list))
+	var out ErrorList
+	for _, err := range list {
+		k := key{errType: err.Type, field: err.Field.String(), badValue: fmt.Sprintf("%v", err.BadValue)}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, err)
+	}
+	return out
+}
+
+// ToAggregate returns a single error that joins every Error in the receiver,
+// mirroring k8s.io/apimachinery/pkg/util/errors.Aggregate. It returns nil if
+// the receiver is empty.
+func (list ErrorList) ToAggregate() error {
+	if len(list) == 0 {
+		return nil
+	}
+	return aggregate(list)
+}
+
+// aggregate implements the error interface over an ErrorList, joining its
+// children's messages.
+type aggregate ErrorList
+
+func (a aggregate) Error() string {
+	if len(a) == 1 {
+		return a[0].Error()
+	}
+	msgs := make([]string, len(a))
+	for i, err := range a {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(msgs, ", "))
+}
+
+// Errors returns the individual errors that make up the aggregate.
+func (a aggregate) Errors() []Error {
+	return ErrorList(a)
+}
+
+// Merge appends other's Errors and Warnings onto the receiver, then
+// deduplicates each list.
+func (result *ManifestResult) Merge(other ManifestResult) {
+	result.Errors = ErrorList(append(result.Errors, other.Errors...)).Dedup()
+	result.Warnings = ErrorList(append(result.Warnings, other.Warnings...)).Dedup()
+}
+
+// MergeResults groups results by Name, merging every result that shares a
+// Name into one, and returns the merged set in first-seen order. This
+// reduces noisy output when the same manifest is checked by multiple
+// Validators.
+func MergeResults(results []ManifestResult) []ManifestResult {
+	order := make([]string, 0, len(results))
+	byName := make(map[string]*ManifestResult, len(results))
+
+	for _, result := range results {
+		existing, ok := byName[result.Name]
+		if !ok {
+			order = append(order, result.Name)
+			existing = &ManifestResult{Name: result.Name}
+			byName[result.Name] = existing
+		}
+		existing.Merge(result)
+	}
+
+	merged := make([]ManifestResult, 0, len(order))
+	for _, name := range order {
+		merged = append(merged, *byName[name])
+	}
+	return merged
+}
@@ -0,0 +1,92 @@
+package validator
+
+import "testing"
+
+func TestErrorListDedup(t *testing.T) {
+	field := NewPath("spec").Child("version")
+	list := ErrorList{
+		MandatoryFieldMissing("spec.version is required", field, nil),
+		MandatoryFieldMissing("spec.version is required", field, nil),
+		OptionalFieldMissing("spec.replicas is recommended", NewPath("spec").Child("replicas"), nil),
+	}
+
+	deduped := list.Dedup()
+	if len(deduped) != 2 {
+		t.Fatalf("expected 2 errors after Dedup, got %d: %+v", len(deduped), deduped)
+	}
+}
+
+func TestErrorListFilter(t *testing.T) {
+	list := ErrorList{
+		MandatoryFieldMissing("required", nil, nil),
+		OptionalFieldMissing("optional", nil, nil),
+	}
+
+	errorsOnly := list.Filter(ErrorFieldMissing)
+	if len(errorsOnly) != 1 || errorsOnly[0].Type != ErrorFieldMissing {
+		t.Errorf("Filter(ErrorFieldMissing) = %+v, want a single ErrorFieldMissing entry", errorsOnly)
+	}
+}
+
+func TestErrorListHasError(t *testing.T) {
+	if (ErrorList{}).HasError() {
+		t.Error("expected an empty ErrorList to report HasError() == false")
+	}
+	if !(ErrorList{MandatoryFieldMissing("x", nil, nil)}).HasError() {
+		t.Error("expected a non-empty ErrorList to report HasError() == true")
+	}
+}
+
+func TestErrorListToAggregate(t *testing.T) {
+	if agg := (ErrorList{}).ToAggregate(); agg != nil {
+		t.Errorf("expected ToAggregate() of an empty list to be nil, got %v", agg)
+	}
+
+	list := ErrorList{
+		MandatoryFieldMissing("spec.version is required", nil, nil),
+		MandatoryFieldMissing("spec.name is required", nil, nil),
+	}
+	agg := list.ToAggregate()
+	if agg == nil {
+		t.Fatal("expected a non-nil aggregate error")
+	}
+	if got := agg.Error(); got == "" {
+		t.Error("expected the aggregate error's message to be non-empty")
+	}
+}
+
+func TestManifestResultMergeDedups(t *testing.T) {
+	field := NewPath("spec").Child("version")
+	a := ManifestResult{Name: "csv.yaml", Errors: []Error{MandatoryFieldMissing("spec.version is required", field, nil)}}
+	b := ManifestResult{Name: "csv.yaml", Errors: []Error{MandatoryFieldMissing("spec.version is required", field, nil)}}
+
+	a.Merge(b)
+	if len(a.Errors) != 1 {
+		t.Fatalf("expected Merge to dedup identical errors, got %+v", a.Errors)
+	}
+}
+
+func TestMergeResultsGroupsByName(t *testing.T) {
+	field := NewPath("spec").Child("version")
+	results := []ManifestResult{
+		{Name: "csv.yaml", Errors: []Error{MandatoryFieldMissing("spec.version is required", field, nil)}},
+		{Name: "crd.yaml", Warnings: []Error{OptionalFieldMissing("spec.description is recommended", nil, nil)}},
+		{Name: "csv.yaml", Errors: []Error{MandatoryFieldMissing("spec.version is required", field, nil)}},
+	}
+
+	merged := MergeResults(results)
+	if len(merged) != 2 {
+		t.Fatalf("expected results to be merged down to 2 manifests, got %d: %+v", len(merged), merged)
+	}
+
+	byName := map[string]ManifestResult{}
+	for _, r := range merged {
+		byName[r.Name] = r
+	}
+	if got := len(byName["csv.yaml"].Errors); got != 1 {
+		t.Errorf("expected csv.yaml's duplicate errors to be deduped to 1, got %d", got)
+	}
+	if got := len(byName["crd.yaml"].Warnings); got != 1 {
+		t.Errorf("expected crd.yaml to retain its 1 warning, got %d", got)
+	}
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Path represents the path from a root object to a particular nested field,
+// mirroring the design of k8s.io/apimachinery's field.Path. It is built up
+// incrementally via Child, Index, and Key, and renders as a dot/bracket
+// separated string (e.g. "spec.install.spec.deployments[0].name") so that
+// tooling can locate the exact offending field inside deeply nested CSV
+// structs instead of parsing free-form strings.
+type Path struct {
+	name   string
+	parent *Path
+}
+
+// NewPath creates a root Path object. Additional names create additional
+// levels of the path, equivalent to calling Child repeatedly.
+func NewPath(name string, moreNames ...string) *Path {
+	r := &Path{name: name}
+	for _, n := range moreNames {
+		r = &Path{name: n, parent: r}
+	}
+	return r
+}
+
+// Child appends a named child field to the receiver, producing a new Path.
+// Additional names create additional levels of the path.
+func (p *Path) Child(name string, moreNames ...string) *Path {
+	r := NewPath(name, moreNames...)
+	r.root().parent = p
+	return r
+}
+
+// Index indicates that the receiver is a slice/array and adds an element
+// index to it, producing a new Path (e.g. "deployments[0]").
+func (p *Path) Index(index int) *Path {
+	return &Path{name: "[" + strconv.Itoa(index) + "]", parent: p}
+}
+
+// Key indicates that the receiver is a map and adds a key to it, producing a
+// new Path (e.g. "annotations[myKey]").
+func (p *Path) Key(key string) *Path {
+	return &Path{name: "[" + key + "]", parent: p}
+}
+
+// root walks up to the first ancestor in the receiver's chain of names.
+func (p *Path) root() *Path {
+	for ; p.parent != nil; p = p.parent {
+	}
+	return p
+}
+
+// String renders p as a dot/bracket separated path, e.g.
+// "spec.install.spec.deployments[0].name". A nil Path renders as "".
+func (p *Path) String() string {
+	if p == nil {
+		return ""
+	}
+
+	// Collect the elements from leaf to root, then render them in reverse.
+	elems := []*Path{}
+	for ; p != nil; p = p.parent {
+		elems = append(elems, p)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 64))
+	for i := len(elems) - 1; i >= 0; i-- {
+		if buf.Len() > 0 && !bracketed(elems[i].name) {
+			buf.WriteString(".")
+		}
+		buf.WriteString(elems[i].name)
+	}
+	return buf.String()
+}
+
+// MarshalText renders p via String(), so encoding/json (and anything else
+// that understands encoding.TextMarshaler) emits the path as a plain string
+// like "spec.install.spec.deployments[0].name" instead of its unexported
+// fields.
+func (p *Path) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// MarshalJSON renders p via String() as a JSON string, to the same effect as
+// MarshalText. encoding/json prefers MarshalJSON when both are implemented,
+// so it is defined explicitly rather than relying on MarshalText alone.
+func (p *Path) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// bracketed reports whether name is an index/key segment (already wrapped in
+// brackets), in which case it should not be preceded by a ".".
+func bracketed(name string) bool {
+	return len(name) > 0 && name[0] == '['
+}
@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPathNilString(t *testing.T) {
+	var p *Path
+	if got := p.String(); got != "" {
+		t.Errorf("nil *Path.String() = %q, want \"\"", got)
+	}
+}
+
+func TestNewPath(t *testing.T) {
+	if got, want := NewPath("spec").String(), "spec"; got != want {
+		t.Errorf("NewPath(%q).String() = %q, want %q", "spec", got, want)
+	}
+	if got, want := NewPath("spec", "install", "spec").String(), "spec.install.spec"; got != want {
+		t.Errorf("NewPath with extra names = %q, want %q", got, want)
+	}
+}
+
+func TestPathChild(t *testing.T) {
+	p := NewPath("spec").Child("install").Child("spec")
+	if got, want := p.String(), "spec.install.spec"; got != want {
+		t.Errorf("Child composition = %q, want %q", got, want)
+	}
+}
+
+func TestPathChildMultipleNames(t *testing.T) {
+	p := NewPath("spec").Child("install", "spec", "deployments")
+	if got, want := p.String(), "spec.install.spec.deployments"; got != want {
+		t.Errorf("Child with multiple names = %q, want %q", got, want)
+	}
+}
+
+func TestPathIndex(t *testing.T) {
+	p := NewPath("spec").Child("deployments").Index(0).Child("name")
+	if got, want := p.String(), "spec.deployments[0].name"; got != want {
+		t.Errorf("Index composition = %q, want %q", got, want)
+	}
+}
+
+func TestPathKey(t *testing.T) {
+	p := NewPath("metadata").Child("annotations").Key("my.key")
+	if got, want := p.String(), "metadata.annotations[my.key]"; got != want {
+		t.Errorf("Key composition = %q, want %q", got, want)
+	}
+}
+
+func TestPathBracketsAreNotDotPrefixed(t *testing.T) {
+	p := NewPath("deployments").Index(2)
+	if got, want := p.String(), "deployments[2]"; got != want {
+		t.Errorf("bracket rendering = %q, want %q (no dot before '[')", got, want)
+	}
+
+	p2 := NewPath("deployments").Index(2).Child("name")
+	if got, want := p2.String(), "deployments[2].name"; got != want {
+		t.Errorf("rendering after a bracket = %q, want %q", got, want)
+	}
+}
+
+func TestPathMarshalText(t *testing.T) {
+	p := NewPath("spec").Child("version")
+	b, err := p.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned an error: %v", err)
+	}
+	if got, want := string(b), "spec.version"; got != want {
+		t.Errorf("MarshalText() = %q, want %q", got, want)
+	}
+}
+
+func TestPathMarshalJSON(t *testing.T) {
+	p := NewPath("spec").Child("version")
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal returned an error: %v", err)
+	}
+	if got, want := string(b), `"spec.version"`; got != want {
+		t.Errorf("json.Marshal(p) = %s, want %s", got, want)
+	}
+}
+
+func TestPathMarshalJSONNil(t *testing.T) {
+	var p *Path
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal returned an error: %v", err)
+	}
+	if got, want := string(b), `null`; got != want {
+		t.Errorf("json.Marshal(nil *Path) = %s, want %s", got, want)
+	}
+}
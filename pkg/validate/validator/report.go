@@ -0,0 +1,224 @@
+package validator
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"sort"
+	"strings"
+)
+
+// Reporter serializes a set of ManifestResults into a machine-readable
+// report format, ex. for consumption by CI pipelines or IDE tooling.
+type Reporter interface {
+	// Report renders results into the Reporter's output format.
+	Report(results []ManifestResult) ([]byte, error)
+}
+
+// JSONReporter renders ManifestResults as plain, indented JSON.
+type JSONReporter struct{}
+
+// Report implements Reporter.
+func (JSONReporter) Report(results []ManifestResult) ([]byte, error) {
+	return json.MarshalIndent(results, "", "  ")
+}
+
+// JUnitReporter renders ManifestResults as a JUnit XML test report, with one
+// testsuite per ManifestResult and one testcase per Error/Warning, so
+// results can be surfaced by CI systems that understand JUnit.
+type JUnitReporter struct{}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",chardata"`
+}
+
+// Report implements Reporter.
+func (JUnitReporter) Report(results []ManifestResult) ([]byte, error) {
+	suites := junitTestSuites{}
+	for _, result := range results {
+		suite := junitTestSuite{
+			Name:     result.Name,
+			Tests:    len(result.Errors) + len(result.Warnings),
+			Failures: len(result.Errors),
+		}
+		for _, err := range result.Errors {
+			suite.TestCases = append(suite.TestCases, junitTestCase{
+				Name: string(err.Type),
+				Failure: &junitFailure{
+					Message: err.Detail,
+					Type:    string(err.Type),
+					Content: err.Error(),
+				},
+			})
+		}
+		for _, warn := range result.Warnings {
+			suite.TestCases = append(suite.TestCases, junitTestCase{Name: string(warn.Type)})
+		}
+		suites.Suites = append(suites.Suites, suite)
+	}
+	return xml.MarshalIndent(suites, "", "  ")
+}
+
+// SARIFReporter renders ManifestResults as a SARIF 2.1.0 log, so they can be
+// surfaced natively in GitHub code-scanning and other SARIF-aware tools.
+type SARIFReporter struct{}
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+const sarifVersion = "2.1.0"
+const sarifDriverName = "new-manifest-verification"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// Report implements Reporter.
+func (SARIFReporter) Report(results []ManifestResult) ([]byte, error) {
+	rules := map[string]struct{}{}
+	run := sarifRun{
+		Tool:    sarifTool{Driver: sarifDriver{Name: sarifDriverName}},
+		Results: []sarifResult{},
+	}
+
+	addResult := func(manifest string, err Error) {
+		rules[string(err.Type)] = struct{}{}
+		loc := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: artifactURI(manifest, err.Field)},
+			},
+		}
+		if err.Field != nil {
+			loc.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: err.Field.String()}}
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    string(err.Type),
+			Level:     sarifLevel(err.Severity),
+			Message:   sarifMessage{Text: err.Detail},
+			Locations: []sarifLocation{loc},
+		})
+	}
+
+	for _, result := range results {
+		for _, err := range result.Errors {
+			addResult(result.Name, err)
+		}
+		for _, warn := range result.Warnings {
+			addResult(result.Name, warn)
+		}
+	}
+
+	ruleIDs := make([]string, 0, len(rules))
+	for ruleID := range rules {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+	for _, ruleID := range ruleIDs {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: ruleID})
+	}
+
+	log := sarifLog{Schema: sarifSchema, Version: sarifVersion, Runs: []sarifRun{run}}
+	return json.MarshalIndent(log, "", "  ")
+}
+
+// sarifLevel maps this module's Severity onto the SARIF result.level enum
+// (none, note, warning, error).
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "note"
+	case SeverityError:
+		return "error"
+	default:
+		return "none"
+	}
+}
+
+// artifactURI builds a SARIF artifactLocation.uri that identifies both the
+// manifest file and, when known, the offending field within it: manifest
+// gets a JSON-Pointer-style "#/spec/deployments/0/name" fragment appended so
+// tools that only render physicalLocation (ex. GitHub code-scanning) can
+// still pinpoint the field.
+func artifactURI(manifest string, field *Path) string {
+	if field == nil {
+		return manifest
+	}
+	return manifest + "#/" + fieldPathToJSONPointer(field)
+}
+
+// fieldPathToJSONPointer converts a Path's dot/bracket rendering, ex.
+// "spec.deployments[0].name", into JSON-Pointer-style segments joined by
+// "/", ex. "spec/deployments/0/name".
+func fieldPathToJSONPointer(field *Path) string {
+	s := field.String()
+	s = strings.ReplaceAll(s, "[", "/")
+	s = strings.ReplaceAll(s, "]", "")
+	s = strings.ReplaceAll(s, ".", "/")
+	return s
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSARIFReporterEmptyResultsIsNotNull(t *testing.T) {
+	out, err := SARIFReporter{}.Report(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("failed to unmarshal SARIF output: %v", err)
+	}
+	if log.Runs[0].Results == nil {
+		t.Fatalf("expected results to be an empty array, got null: %s", out)
+	}
+	if !strings.Contains(string(out), `"results": []`) {
+		t.Errorf("expected literal \"results\": [], got %s", out)
+	}
+}
+
+func TestJSONReporterCarriesFieldPath(t *testing.T) {
+	results := []ManifestResult{
+		{
+			Name: "csv.yaml",
+			Errors: []Error{
+				MandatoryFieldMissing("spec.version is required", NewPath("spec").Child("version"), nil),
+			},
+		},
+	}
+
+	out, err := JSONReporter{}.Report(results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var manifests []struct {
+		Errors []struct {
+			Field string `json:"Field"`
+		} `json:"Errors"`
+	}
+	if err := json.Unmarshal(out, &manifests); err != nil {
+		t.Fatalf("failed to unmarshal JSON output: %v", err)
+	}
+
+	if len(manifests) != 1 || len(manifests[0].Errors) != 1 {
+		t.Fatalf("expected one manifest with one error, got %+v", manifests)
+	}
+	if got, want := manifests[0].Errors[0].Field, "spec.version"; got != want {
+		t.Errorf("JSON Field = %q, want %q", got, want)
+	}
+	if strings.Contains(string(out), `"Field": {}`) {
+		t.Errorf("expected Field to render as a string, not an empty object: %s", out)
+	}
+}
+
+func TestSARIFReporterPhysicalLocationCarriesFieldPath(t *testing.T) {
+	results := []ManifestResult{
+		{
+			Name: "csv.yaml",
+			Errors: []Error{
+				MandatoryFieldMissing("spec.version is required", NewPath("spec").Child("version"), nil),
+			},
+		},
+	}
+
+	out, err := SARIFReporter{}.Report(results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(out, &log); err != nil {
+		t.Fatalf("failed to unmarshal SARIF output: %v", err)
+	}
+
+	loc := log.Runs[0].Results[0].Locations[0]
+	wantURI := "csv.yaml#/spec/version"
+	if got := loc.PhysicalLocation.ArtifactLocation.URI; got != wantURI {
+		t.Errorf("physicalLocation.artifactLocation.uri = %q, want %q", got, wantURI)
+	}
+}
@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/blang/semver"
+)
+
+// RuleFunc checks a single field's value against a named rule. param holds
+// everything after the rule's "=" in a struct tag, ex. "a b c" for
+// `validate:"oneof=a b c"`. A nil return means the value passed.
+type RuleFunc func(value interface{}, param string) *Error
+
+// RuleRegistry lets third parties register named rules and attach them to
+// CSV/CRD struct fields via `validate:"rule,rule=param"` tags, mirroring the
+// ergonomics of github.com/go-playground/validator while producing this
+// module's ManifestResult output.
+type RuleRegistry struct {
+	mu    sync.RWMutex
+	rules map[string]RuleFunc
+}
+
+// NewRuleRegistry creates a RuleRegistry seeded with this module's built-in
+// rules: semver, url, image, dns1123, oneof, and required.
+func NewRuleRegistry() *RuleRegistry {
+	r := &RuleRegistry{rules: map[string]RuleFunc{}}
+	r.RegisterRule("required", ruleRequired)
+	r.RegisterRule("semver", ruleSemver)
+	r.RegisterRule("url", ruleURL)
+	r.RegisterRule("image", ruleImage)
+	r.RegisterRule("dns1123", ruleDNS1123)
+	r.RegisterRule("oneof", ruleOneOf)
+	return r
+}
+
+// RegisterRule adds fn to the receiver under name, overwriting any rule
+// previously registered under that name.
+func (r *RuleRegistry) RegisterRule(name string, fn RuleFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules[name] = fn
+}
+
+// Validate reflectively walks v, a pointer to a parsed CSV/CRD struct,
+// invoking every rule named in each field's `validate` tag and collecting
+// the resulting Errors with their structured field paths filled in.
+func (r *RuleRegistry) Validate(v interface{}) []Error {
+	var errs []Error
+	r.walk(reflect.ValueOf(v), nil, &errs)
+	return errs
+}
+
+func (r *RuleRegistry) walk(val reflect.Value, path *Path, errs *[]Error) {
+	if !val.IsValid() {
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if val.IsNil() {
+			return
+		}
+		r.walk(val.Elem(), path, errs)
+	case reflect.Struct:
+		t := val.Type()
+		for i := 0; i < val.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" { // unexported
+				continue
+			}
+			fieldVal := val.Field(i)
+			fieldPath := childPath(path, fieldDisplayName(field))
+			if tag, ok := field.Tag.Lookup("validate"); ok {
+				r.applyRules(tag, fieldVal, fieldPath, errs)
+			}
+			r.walk(fieldVal, fieldPath, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			r.walk(val.Index(i), path.Index(i), errs)
+		}
+	case reflect.Map:
+		for _, key := range val.MapKeys() {
+			r.walk(val.MapIndex(key), path.Key(fmt.Sprintf("%v", key.Interface())), errs)
+		}
+	}
+}
+
+// applyRules runs every comma-separated rule clause in tag against
+// fieldVal, appending a field-pathed Error for each clause that fails.
+func (r *RuleRegistry) applyRules(tag string, fieldVal reflect.Value, path *Path, errs *[]Error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, clause := range strings.Split(tag, ",") {
+		name, param := clause, ""
+		if idx := strings.IndexByte(clause, '='); idx >= 0 {
+			name, param = clause[:idx], clause[idx+1:]
+		}
+		fn, ok := r.rules[name]
+		if !ok {
+			continue
+		}
+		if err := fn(fieldVal.Interface(), param); err != nil {
+			err.Field = path
+			*errs = append(*errs, *err)
+		}
+	}
+}
+
+// childPath appends name to path, treating a nil path as the struct root.
+func childPath(path *Path, name string) *Path {
+	if path == nil {
+		return NewPath(name)
+	}
+	return path.Child(name)
+}
+
+// fieldDisplayName prefers a struct field's json tag name (the name it will
+// have in the manifest) over its Go field name.
+func fieldDisplayName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		if name := strings.Split(tag, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func ruleRequired(value interface{}, _ string) *Error {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || v.IsZero() {
+		err := MandatoryFieldMissing("value is required", nil, value)
+		return &err
+	}
+	return nil
+}
+
+func ruleSemver(value interface{}, _ string) *Error {
+	s, ok := value.(string)
+	if !ok {
+		err := UnsupportedType("semver rule requires a string value", nil)
+		return &err
+	}
+	if _, err := semver.ParseTolerant(s); err != nil {
+		e := FailedValidation(fmt.Sprintf("%q is not a valid semantic version", s), nil, s)
+		return &e
+	}
+	return nil
+}
+
+func ruleURL(value interface{}, _ string) *Error {
+	s, ok := value.(string)
+	if !ok {
+		err := UnsupportedType("url rule requires a string value", nil)
+		return &err
+	}
+	if _, err := url.ParseRequestURI(s); err != nil {
+		e := FailedValidation(fmt.Sprintf("%q is not a valid URL", s), nil, s)
+		return &e
+	}
+	return nil
+}
+
+// imageRefRegexp loosely matches a container image reference, ex.
+// "quay.io/my-org/my-operator:v1.2.3", "localhost:5000/ns/name:tag", or
+// "...@sha256:<digest>". The optional ":<port>" right after the host
+// segment covers registries addressed with an explicit port.
+var imageRefRegexp = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)*(:[\w][\w.-]{0,127})?(@sha256:[A-Fa-f0-9]{64})?$`)
+
+func ruleImage(value interface{}, _ string) *Error {
+	s, ok := value.(string)
+	if !ok {
+		err := UnsupportedType("image rule requires a string value", nil)
+		return &err
+	}
+	if !imageRefRegexp.MatchString(s) {
+		e := FailedValidation(fmt.Sprintf("%q is not a valid image reference", s), nil, s)
+		return &e
+	}
+	return nil
+}
+
+// dns1123Regexp matches a DNS-1123 subdomain, mirroring
+// k8s.io/apimachinery/pkg/util/validation.IsDNS1123Subdomain.
+var dns1123Regexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+func ruleDNS1123(value interface{}, _ string) *Error {
+	s, ok := value.(string)
+	if !ok {
+		err := UnsupportedType("dns1123 rule requires a string value", nil)
+		return &err
+	}
+	if len(s) > 253 || !dns1123Regexp.MatchString(s) {
+		e := FailedValidation(fmt.Sprintf("%q is not a valid DNS-1123 subdomain", s), nil, s)
+		return &e
+	}
+	return nil
+}
+
+func ruleOneOf(value interface{}, param string) *Error {
+	s := fmt.Sprintf("%v", value)
+	for _, opt := range strings.Fields(param) {
+		if opt == s {
+			return nil
+		}
+	}
+	e := FailedValidation(fmt.Sprintf("%q is not one of %q", s, param), nil, value)
+	return &e
+}
@@ -0,0 +1,132 @@
+package validator
+
+import "testing"
+
+type testDeployment struct {
+	Name string `json:"name" validate:"required,dns1123"`
+}
+
+type testCSVSpec struct {
+	Version     string           `json:"version" validate:"required,semver"`
+	Image       string           `json:"image" validate:"image"`
+	Channel     string           `json:"channel" validate:"oneof=alpha beta stable"`
+	Deployments []testDeployment `json:"deployments"`
+}
+
+type testCSV struct {
+	Spec testCSVSpec `json:"spec"`
+}
+
+func TestRuleRegistryValidateNestedStruct(t *testing.T) {
+	csv := &testCSV{
+		Spec: testCSVSpec{
+			Version: "v1.2.3",
+			Image:   "localhost:5000/ns/name:latest",
+			Channel: "stable",
+			Deployments: []testDeployment{
+				{Name: "my-operator"},
+			},
+		},
+	}
+
+	errs := NewRuleRegistry().Validate(csv)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors for a valid CSV, got %v", errs)
+	}
+}
+
+func TestRuleRegistryValidateReportsFieldPaths(t *testing.T) {
+	csv := &testCSV{
+		Spec: testCSVSpec{
+			Version: "not-a-semver",
+			Image:   "NOT_VALID/image",
+			Channel: "nightly",
+			Deployments: []testDeployment{
+				{Name: ""},
+			},
+		},
+	}
+
+	errs := NewRuleRegistry().Validate(csv)
+
+	byField := map[string][]Error{}
+	for _, err := range errs {
+		field := err.Field.String()
+		byField[field] = append(byField[field], err)
+	}
+
+	wantFields := []string{
+		"spec.version",
+		"spec.image",
+		"spec.channel",
+		"spec.deployments[0].name",
+	}
+	for _, field := range wantFields {
+		if _, ok := byField[field]; !ok {
+			t.Errorf("expected an error for field %q, got errors for %v", field, byField)
+		}
+	}
+
+	foundRequired := false
+	for _, err := range byField["spec.deployments[0].name"] {
+		if err.Type == ErrorFieldMissing {
+			foundRequired = true
+		}
+	}
+	if !foundRequired {
+		t.Errorf("expected spec.deployments[0].name to include a required-field error, got %v", byField["spec.deployments[0].name"])
+	}
+}
+
+// testAnnotationValue and mapHolder exist purely to exercise walk()'s map
+// branch (and therefore Path.Key()), which no other test in the series
+// reaches: every other struct under test only nests via fields/slices.
+type testAnnotationValue struct {
+	Value string `json:"value" validate:"dns1123"`
+}
+
+type mapHolder struct {
+	Annotations map[string]testAnnotationValue `json:"annotations"`
+}
+
+func TestRuleRegistryValidateMapField(t *testing.T) {
+	holder := &mapHolder{
+		Annotations: map[string]testAnnotationValue{
+			"team": {Value: "Not_Valid!!"},
+		},
+	}
+
+	errs := NewRuleRegistry().Validate(holder)
+	if len(errs) != 1 {
+		t.Fatalf("expected one error from the invalid map entry, got %v", errs)
+	}
+	if got, want := errs[0].Field.String(), "annotations[team].value"; got != want {
+		t.Errorf("Field = %q, want %q", got, want)
+	}
+}
+
+func TestRuleImage(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"quay.io/my-org/my-operator:v1.2.3", true},
+		{"localhost:5000/my/image:latest", true},
+		{"registry.example.com:5000/ns/name:tag", true},
+		{"NOT_VALID/image", false},
+	}
+	for _, test := range tests {
+		if err := ruleImage(test.value, ""); (err == nil) != test.valid {
+			t.Errorf("ruleImage(%q) valid=%v, want valid=%v (err=%v)", test.value, err == nil, test.valid, err)
+		}
+	}
+}
+
+func TestRuleOneOf(t *testing.T) {
+	if err := ruleOneOf("beta", "alpha beta stable"); err != nil {
+		t.Errorf("expected \"beta\" to satisfy oneof=alpha beta stable, got %v", err)
+	}
+	if err := ruleOneOf("nightly", "alpha beta stable"); err == nil {
+		t.Errorf("expected \"nightly\" to fail oneof=alpha beta stable")
+	}
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// SchemaValidator is a Validator that checks CSV, package.yaml, and CRD
+// documents against a caller-supplied JSON Schema (draft-07). It lets users
+// drop in custom schemas per operator without recompiling this module.
+type SchemaValidator struct {
+	name    string
+	schema  *gojsonschema.Schema
+	targets []string
+}
+
+// NewSchemaValidator creates a SchemaValidator that checks each file in
+// targets against the draft-07 JSON Schema loaded from schemaPath.
+func NewSchemaValidator(schemaPath string, targets []string) (Validator, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://" + schemaPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load JSON schema %q: %v", schemaPath, err)
+	}
+	return &SchemaValidator{
+		name:    fmt.Sprintf("SchemaValidator(%s)", schemaPath),
+		schema:  schema,
+		targets: targets,
+	}, nil
+}
+
+// Name implements Validator.
+func (v *SchemaValidator) Name() string {
+	return v.name
+}
+
+// Validate implements Validator. It runs the receiver's schema against each
+// of its targets and returns one ManifestResult per target.
+func (v *SchemaValidator) Validate() []ManifestResult {
+	results := make([]ManifestResult, 0, len(v.targets))
+	for _, target := range v.targets {
+		results = append(results, v.validateOne(target))
+	}
+	return results
+}
+
+func (v *SchemaValidator) validateOne(target string) ManifestResult {
+	result := ManifestResult{Name: target}
+
+	raw, err := ioutil.ReadFile(target)
+	if err != nil {
+		result.Errors = append(result.Errors, IOError("failed to read manifest for schema validation", target))
+		return result
+	}
+
+	// CSV/package.yaml/CRD documents are YAML; gojsonschema only understands
+	// JSON-compatible documents, so convert before validating.
+	jsonRaw, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		result.Errors = append(result.Errors, InvalidParse("failed to parse manifest as YAML", nil, target))
+		return result
+	}
+
+	schemaResult, err := v.schema.Validate(gojsonschema.NewBytesLoader(jsonRaw))
+	if err != nil {
+		result.Errors = append(result.Errors, InvalidOperation("failed to run schema validation", target))
+		return result
+	}
+
+	for _, re := range schemaResult.Errors() {
+		result.appendSchemaError(re)
+	}
+	return result
+}
+
+// appendSchemaError translates a single gojsonschema result error into this
+// module's Error type and files it as an Error or Warning depending on its
+// severity.
+func (result *ManifestResult) appendSchemaError(re gojsonschema.ResultError) {
+	field := pathFromJSONPointer(re.Field())
+	switch re.Type() {
+	case "required":
+		result.Errors = append(result.Errors, MandatoryFieldMissing(re.Description(), field, re.Value()))
+	case "additional_property_not_allowed":
+		result.Warnings = append(result.Warnings, UnknownField(re.Description(), field, re.Value()))
+	case "invalid_type":
+		result.Errors = append(result.Errors, UnsupportedType(re.Description(), field))
+	default:
+		result.Errors = append(result.Errors, FailedValidation(re.Description(), field, re.Value()))
+	}
+}
+
+// pathFromJSONPointer converts a gojsonschema field path, ex.
+// "(root).spec.install.spec.deployments.0.name", into a structured Path,
+// ex. "spec.install.spec.deployments[0].name".
+func pathFromJSONPointer(field string) *Path {
+	segments := strings.Split(field, ".")
+	var path *Path
+	for _, seg := range segments {
+		if seg == "" || seg == "(root)" {
+			continue
+		}
+		if index, err := strconv.Atoi(seg); err == nil {
+			path = path.Index(index)
+			continue
+		}
+		if path == nil {
+			path = NewPath(seg)
+			continue
+		}
+		path = path.Child(seg)
+	}
+	return path
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "spec": {
+      "type": "object",
+      "properties": {
+        "version": {"type": "string"},
+        "replicas": {"type": "integer"}
+      },
+      "required": ["version"],
+      "additionalProperties": false
+    }
+  }
+}`
+
+const testManifest = `
+spec:
+  replicas: "three"
+  extra: true
+`
+
+func TestSchemaValidatorTranslatesSchemaErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	schemaPath := filepath.Join(dir, "schema.json")
+	if err := os.WriteFile(schemaPath, []byte(testSchema), 0o644); err != nil {
+		t.Fatalf("failed to write schema: %v", err)
+	}
+
+	manifestPath := filepath.Join(dir, "csv.yaml")
+	if err := os.WriteFile(manifestPath, []byte(testManifest), 0o644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	v, err := NewSchemaValidator(schemaPath, []string{manifestPath})
+	if err != nil {
+		t.Fatalf("NewSchemaValidator returned an error: %v", err)
+	}
+
+	results := v.Validate()
+	if len(results) != 1 {
+		t.Fatalf("expected one ManifestResult, got %d", len(results))
+	}
+	result := results[0]
+
+	var sawMissingVersion, sawBadType bool
+	for _, e := range result.Errors {
+		switch e.Type {
+		case ErrorFieldMissing:
+			sawMissingVersion = true
+		case ErrorUnsupportedType:
+			sawBadType = true
+		}
+	}
+	if !sawMissingVersion {
+		t.Errorf("expected a MandatoryFieldMissing error for spec.version, got %+v", result.Errors)
+	}
+	if !sawBadType {
+		t.Errorf("expected an UnsupportedType error for spec.replicas, got %+v", result.Errors)
+	}
+
+	var sawUnknownField bool
+	for _, w := range result.Warnings {
+		if w.Type == WarningUnknownField {
+			sawUnknownField = true
+		}
+	}
+	if !sawUnknownField {
+		t.Errorf("expected a WarningUnknownField warning for spec.extra, got %+v", result.Warnings)
+	}
+}
+
+func TestSchemaValidatorValidManifestHasNoErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	schemaPath := filepath.Join(dir, "schema.json")
+	if err := os.WriteFile(schemaPath, []byte(testSchema), 0o644); err != nil {
+		t.Fatalf("failed to write schema: %v", err)
+	}
+
+	manifestPath := filepath.Join(dir, "csv.yaml")
+	if err := os.WriteFile(manifestPath, []byte("spec:\n  version: \"1.0.0\"\n  replicas: 3\n"), 0o644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	v, err := NewSchemaValidator(schemaPath, []string{manifestPath})
+	if err != nil {
+		t.Fatalf("NewSchemaValidator returned an error: %v", err)
+	}
+
+	result := v.Validate()[0]
+	if len(result.Errors) != 0 || len(result.Warnings) != 0 {
+		t.Errorf("expected no errors/warnings for a valid manifest, got errors=%+v warnings=%+v", result.Errors, result.Warnings)
+	}
+}
@@ -22,12 +22,34 @@ type Error struct {
 	// Type is the ErrorType string constant that represents the kind of
 	// error, ex. "MandatoryStructMissing", "I/O".
 	Type ErrorType
-	// Field is the dot-hierarchical YAML path of the missing data.
-	Field string
+	// Field is the structured path to the offending field within the
+	// manifest, ex. "spec.install.spec.deployments[0].name". It is nil when
+	// an error cannot be attributed to a single field (ex. file-level I/O
+	// errors).
+	Field *Path
 	// BadValue is the field or file that caused an error or warning.
 	BadValue interface{}
 	// Detail represents the error message as a string.
 	Detail string
+	// Severity classifies how serious the Error is, independent of its
+	// ErrorType, for consumers (ex. Reporters) that need to triage output.
+	Severity Severity
+}
+
+// Severity classifies how serious an Error is, independent of its
+// ErrorType. Unlike ErrorType, which identifies what went wrong, Severity
+// identifies how much it matters to the caller.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// String converts a Severity into its corresponding canonical string.
+func (s Severity) String() string {
+	return string(s)
 }
 
 func (err Error) String() string {
@@ -37,41 +59,45 @@ func (err Error) String() string {
 
 type ErrorType string
 
-func InvalidCSV(detail string) Error {
-	return Error{ErrorInvalidCSV, "", "", detail}
+func InvalidCSV(detail string, field *Path) Error {
+	return Error{Type: ErrorInvalidCSV, Field: field, BadValue: "", Detail: detail, Severity: SeverityError}
 }
 
 func InvalidDefaultChannel(detail string, value interface{}) Error {
-	return Error{ErrorInvalidDefaultChannel, "", value, detail}
+	return Error{Type: ErrorInvalidDefaultChannel, Field: nil, BadValue: value, Detail: detail, Severity: SeverityError}
+}
+
+func OptionalFieldMissing(detail string, field *Path, value interface{}) Error {
+	return Error{Type: WarningFieldMissing, Field: field, BadValue: value, Detail: detail, Severity: SeverityWarning}
 }
 
-func OptionalFieldMissing(detail string, field string, value interface{}) Error {
-	return Error{WarningFieldMissing, field, value, detail}
+func MandatoryFieldMissing(detail string, field *Path, value interface{}) Error {
+	return Error{Type: ErrorFieldMissing, Field: field, BadValue: value, Detail: detail, Severity: SeverityError}
 }
 
-func MandatoryFieldMissing(detail string, field string, value interface{}) Error {
-	return Error{ErrorFieldMissing, field, value, detail}
+func UnknownField(detail string, field *Path, value interface{}) Error {
+	return Error{Type: WarningUnknownField, Field: field, BadValue: value, Detail: detail, Severity: SeverityWarning}
 }
 
-func UnsupportedType(detail string) Error {
-	return Error{ErrorUnsupportedType, "", "", detail}
+func UnsupportedType(detail string, field *Path) Error {
+	return Error{Type: ErrorUnsupportedType, Field: field, BadValue: "", Detail: detail, Severity: SeverityError}
 }
 
 // TODO: see if more information can be extracted out of 'unmarshall/parsing' errors.
-func InvalidParse(detail string, value interface{}) Error {
-	return Error{ErrorInvalidParse, "", value, detail}
+func InvalidParse(detail string, field *Path, value interface{}) Error {
+	return Error{Type: ErrorInvalidParse, Field: field, BadValue: value, Detail: detail, Severity: SeverityError}
 }
 
 func IOError(detail string, value interface{}) Error {
-	return Error{ErrorIO, "", value, detail}
+	return Error{Type: ErrorIO, Field: nil, BadValue: value, Detail: detail, Severity: SeverityError}
 }
 
-func FailedValidation(detail string, value interface{}) Error {
-	return Error{ErrorFailedValidation, "", value, detail}
+func FailedValidation(detail string, field *Path, value interface{}) Error {
+	return Error{Type: ErrorFailedValidation, Field: field, BadValue: value, Detail: detail, Severity: SeverityError}
 }
 
 func InvalidOperation(detail string, value interface{}) Error {
-	return Error{ErrorInvalidOperation, "", value, detail}
+	return Error{Type: ErrorInvalidOperation, Field: nil, BadValue: value, Detail: detail, Severity: SeverityError}
 }
 
 const (
@@ -84,6 +110,7 @@ const (
 	ErrorFailedValidation      ErrorType = "ValidationFailed"
 	ErrorInvalidOperation      ErrorType = "OperationFailed"
 	ErrorInvalidDefaultChannel ErrorType = "DefaultChannelNotValid"
+	WarningUnknownField        ErrorType = "UnknownFieldNotAllowed"
 )
 
 // String converts a ErrorType into its corresponding canonical error message.
@@ -107,45 +134,19 @@ func (t ErrorType) String() string {
 		return "Operation failed"
 	case ErrorInvalidDefaultChannel:
 		return "Default channel not found"
+	case WarningUnknownField:
+		return "Unknown field not allowed"
 	default:
 		panic(fmt.Sprintf("Unrecognized validation error: %q", string(t)))
 	}
 }
 
 // Error strut implements the 'error' interface to define custom error formatting.
+// It renders as "path: detail (badValue=...)", falling back to just the
+// detail and bad value when the error has no associated field path.
 func (err Error) Error() string {
-	return err.Detail
-}
-
-// ValidatorSet contains a set of Validators to be executed sequentially.
-// TODO: add configurable logger.
-type ValidatorSet struct {
-	validators []Validator
-}
-
-// NewValidatorSet creates a ValidatorSet containing vs.
-func NewValidatorSet(vs ...Validator) *ValidatorSet {
-	set := &ValidatorSet{}
-	set.AddValidators(vs...)
-	return set
-}
-
-// AddValidators adds each unique Validator in vs to the receiver.
-func (set *ValidatorSet) AddValidators(vs ...Validator) {
-	seenNames := map[string]struct{}{}
-	for _, v := range vs {
-		if _, seen := seenNames[v.Name()]; !seen {
-			set.validators = append(set.validators, v)
-			seenNames[v.Name()] = struct{}{}
-		}
-	}
-}
-
-// ValidateAll runs each Validator in the receiver and returns all results.
-func (set ValidatorSet) ValidateAll() (allResults []ManifestResult) {
-	for _, v := range set.validators {
-		results := v.Validate()
-		allResults = append(allResults, results...)
+	if err.Field == nil {
+		return fmt.Sprintf("%s (badValue=%v)", err.Detail, err.BadValue)
 	}
-	return allResults
+	return fmt.Sprintf("%s: %s (badValue=%v)", err.Field.String(), err.Detail, err.BadValue)
 }
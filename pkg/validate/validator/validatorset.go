@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Validator is implemented by any type that can check a manifest bundle and
+// report the results.
+type Validator interface {
+	// Name returns a human readable name for the Validator, used to
+	// identify it in logs and in ErrorInvalidOperation results.
+	Name() string
+	// Validate runs validation and returns a ManifestResult for each file
+	// that was checked.
+	Validate() []ManifestResult
+}
+
+// ContextValidator is an optional extension of Validator for implementations
+// that can abandon their work when ctx is canceled or its deadline expires.
+// ValidatorSet.runOne prefers this interface when a Validator implements it,
+// since a plain Validator has no cancellation hook: if it runs past
+// SetTimeout, runOne gives up waiting on it, but the Validate() call keeps
+// running in its own goroutine until it finishes on its own.
+type ContextValidator interface {
+	Validator
+	// ValidateContext behaves like Validate, but must return promptly once
+	// ctx is done.
+	ValidateContext(ctx context.Context) []ManifestResult
+}
+
+// defaultConcurrency is used when SetConcurrency has not been called or has
+// been called with a non-positive value.
+const defaultConcurrency = 4
+
+// ValidatorSet contains a set of Validators to be executed.
+// TODO: add configurable logger.
+type ValidatorSet struct {
+	validators  []Validator
+	concurrency int
+	timeout     time.Duration
+}
+
+// NewValidatorSet creates a ValidatorSet containing vs.
+func NewValidatorSet(vs ...Validator) *ValidatorSet {
+	set := &ValidatorSet{}
+	set.AddValidators(vs...)
+	return set
+}
+
+// AddValidators adds each unique Validator in vs to the receiver.
+func (set *ValidatorSet) AddValidators(vs ...Validator) {
+	seenNames := map[string]struct{}{}
+	for _, v := range vs {
+		if _, seen := seenNames[v.Name()]; !seen {
+			set.validators = append(set.validators, v)
+			seenNames[v.Name()] = struct{}{}
+		}
+	}
+}
+
+// SetConcurrency sets the number of Validators that may run at once. A
+// non-positive n resets the receiver to the default concurrency.
+func (set *ValidatorSet) SetConcurrency(n int) {
+	set.concurrency = n
+}
+
+// SetTimeout sets the per-Validator deadline enforced by ValidateAllContext.
+// A Validator that has not returned within d surfaces as an
+// ErrorInvalidOperation result rather than blocking the remaining
+// Validators. A zero d (the default) disables the deadline.
+func (set *ValidatorSet) SetTimeout(d time.Duration) {
+	set.timeout = d
+}
+
+// ValidateAll runs each Validator in the receiver, in parallel, and returns
+// all results. It is equivalent to calling ValidateAllContext with
+// context.Background() and discarding the error.
+func (set ValidatorSet) ValidateAll() []ManifestResult {
+	allResults, _ := set.ValidateAllContext(context.Background())
+	return allResults
+}
+
+// ValidateAllContext runs each Validator in the receiver using a worker pool
+// sized by SetConcurrency, honoring ctx's cancellation and, if SetTimeout
+// was called, a per-Validator deadline. A Validator that exceeds its
+// deadline does not block the others: its slot surfaces an
+// ErrorInvalidOperation result naming the validator in BadValue. The
+// returned error is non-nil only if ctx itself was canceled or timed out.
+func (set ValidatorSet) ValidateAllContext(ctx context.Context) ([]ManifestResult, error) {
+	concurrency := set.concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+
+	allResults := make([][]ManifestResult, len(set.validators))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, v := range set.validators {
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, v Validator) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			allResults[i] = set.runOne(ctx, v)
+		}(i, v)
+	}
+	wg.Wait()
+
+	var combined []ManifestResult
+	for _, results := range allResults {
+		combined = append(combined, results...)
+	}
+	return combined, ctx.Err()
+}
+
+// runOne runs v under the receiver's per-validator timeout (if any),
+// returning an ErrorInvalidOperation result in place of v's own results if
+// it does not finish in time. If v implements ContextValidator, its
+// ValidateContext is called so it can actually abandon the work once the
+// deadline expires; a plain Validator has no such hook, so its Validate()
+// goroutine is left to finish on its own time instead.
+func (set ValidatorSet) runOne(ctx context.Context, v Validator) []ManifestResult {
+	if set.timeout <= 0 {
+		if cv, ok := v.(ContextValidator); ok {
+			return cv.ValidateContext(ctx)
+		}
+		return v.Validate()
+	}
+
+	vctx, cancel := context.WithTimeout(ctx, set.timeout)
+	defer cancel()
+
+	done := make(chan []ManifestResult, 1)
+	if cv, ok := v.(ContextValidator); ok {
+		go func() { done <- cv.ValidateContext(vctx) }()
+	} else {
+		go func() { done <- v.Validate() }()
+	}
+
+	select {
+	case results := <-done:
+		return results
+	case <-vctx.Done():
+		return []ManifestResult{{
+			Name: v.Name(),
+			Errors: []Error{
+				InvalidOperation("validator did not complete before its deadline", v.Name()),
+			},
+		}}
+	}
+}
@@ -0,0 +1,95 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// slowValidator is a plain Validator (no ContextValidator) that blocks for
+// delay before returning, simulating a hanging bundle validator.
+type slowValidator struct {
+	name  string
+	delay time.Duration
+}
+
+func (v *slowValidator) Name() string { return v.name }
+
+func (v *slowValidator) Validate() []ManifestResult {
+	time.Sleep(v.delay)
+	return []ManifestResult{{Name: v.name}}
+}
+
+func TestValidatorSetValidateAllContextTimeout(t *testing.T) {
+	set := NewValidatorSet(&slowValidator{name: "slow", delay: 200 * time.Millisecond})
+	set.SetTimeout(10 * time.Millisecond)
+
+	start := time.Now()
+	results, err := set.ValidateAllContext(context.Background())
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("ValidateAllContext should return as soon as the deadline expires, took %v", elapsed)
+	}
+	if err != nil {
+		t.Fatalf("expected no error from an uncanceled context, got %v", err)
+	}
+	if len(results) != 1 || len(results[0].Errors) != 1 {
+		t.Fatalf("expected a single ErrorInvalidOperation result, got %+v", results)
+	}
+	if got := results[0].Errors[0].Type; got != ErrorInvalidOperation {
+		t.Errorf("expected ErrorInvalidOperation, got %v", got)
+	}
+	if got := results[0].Errors[0].BadValue; got != "slow" {
+		t.Errorf("expected BadValue to name the timed-out validator, got %v", got)
+	}
+}
+
+func TestValidatorSetValidateAllContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	set := NewValidatorSet(&slowValidator{name: "a", delay: time.Millisecond})
+	if _, err := set.ValidateAllContext(ctx); err == nil {
+		t.Fatal("expected ValidateAllContext to surface the canceled context's error")
+	}
+}
+
+// cancelAwareValidator implements ContextValidator and reports, via exited,
+// the moment its ValidateContext call actually returns.
+type cancelAwareValidator struct {
+	name   string
+	exited chan struct{}
+}
+
+func (v *cancelAwareValidator) Name() string { return v.name }
+
+func (v *cancelAwareValidator) Validate() []ManifestResult {
+	return v.ValidateContext(context.Background())
+}
+
+func (v *cancelAwareValidator) ValidateContext(ctx context.Context) []ManifestResult {
+	defer close(v.exited)
+	select {
+	case <-time.After(200 * time.Millisecond):
+		return []ManifestResult{{Name: v.name}}
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func TestValidatorSetRunOneAbandonsContextValidator(t *testing.T) {
+	v := &cancelAwareValidator{name: "ctx-aware", exited: make(chan struct{})}
+	set := NewValidatorSet(v)
+	set.SetTimeout(10 * time.Millisecond)
+
+	if _, err := set.ValidateAllContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case <-v.exited:
+		// A ContextValidator is expected to stop promptly once its
+		// deadline expires, rather than run for its full delay.
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("ContextValidator was not abandoned promptly after its deadline expired")
+	}
+}